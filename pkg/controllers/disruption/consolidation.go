@@ -20,11 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
 	"github.com/samber/lo"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -74,20 +79,140 @@ func MakeConsolidation(clock clock.Clock, cluster *state.Cluster, kubeClient cli
 	}
 }
 
-// sortAndFilterCandidates orders candidates by the disruptionCost, removing any that we already know won't
-// be viable consolidation options.
+// sortAndFilterCandidates orders candidates by their weighted disruption cost, removing any that we already know
+// won't be viable consolidation options.
 func (c *consolidation) sortAndFilterCandidates(ctx context.Context, candidates []*Candidate) ([]*Candidate, error) {
 	candidates, err := filterCandidates(ctx, c.kubeClient, c.recorder, candidates)
 	if err != nil {
 		return nil, fmt.Errorf("filtering candidates, %w", err)
 	}
 
+	// Reset disruptionCandidateCostGauge before repopulating it below. Candidates come and go every reconcile as
+	// nodes are created, consolidated away, or become ineligible, so without this the per-node series would grow
+	// unbounded over the life of the cluster instead of only ever reflecting the current candidate set.
+	disruptionCandidateCostGauge.Reset()
+
+	// pdbsByNamespace caches PodDisruptionBudgetList lookups across candidates that share a namespace, since
+	// weightedDisruptionCost would otherwise re-list the same PDBs once per pod.
+	pdbsByNamespace := map[string][]policyv1.PodDisruptionBudget{}
+	weightedCost := make(map[*Candidate]float64, len(candidates))
+	for _, cn := range candidates {
+		cost, err := c.weightedDisruptionCost(ctx, cn, pdbsByNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("computing disruption cost for candidate %q, %w", cn.Node.Name, err)
+		}
+		weightedCost[cn] = cost
+	}
+
 	sort.Slice(candidates, func(i int, j int) bool {
-		return candidates[i].disruptionCost < candidates[j].disruptionCost
+		return weightedCost[candidates[i]] < weightedCost[candidates[j]]
 	})
 	return candidates, nil
 }
 
+// weightedDisruptionCost layers signals on top of the candidate's base disruptionCost so that consolidation prefers
+// tearing down "cheap" nodes first: pods covered by a PDB that currently has no disruptions allowed, pods carrying
+// the do-not-disrupt annotation (which validation would otherwise have to evict), and pods bound to ReadWriteOnce
+// PVCs that force a detach/attach cycle on replacement. Weights are configurable via options.Options so operators
+// can tune how much each signal matters relative to the node's lifetime-based disruptionCost. It also records the
+// per-component breakdown on disruptionCandidateCostGauge for observability.
+func (c *consolidation) weightedDisruptionCost(ctx context.Context, candidate *Candidate, pdbsByNamespace map[string][]policyv1.PodDisruptionBudget) (float64, error) {
+	weights := options.FromContext(ctx).DisruptionCostWeights
+
+	pdbCount, err := pdbBlockedPodCount(ctx, c.kubeClient, candidate, pdbsByNamespace)
+	if err != nil {
+		return 0, fmt.Errorf("counting PDB-covered pods, %w", err)
+	}
+	doNotDisruptCount := lo.CountBy(candidate.pods, func(p *v1.Pod) bool {
+		return p.Annotations[v1beta1.DoNotDisruptAnnotationKey] == "true"
+	})
+	statefulCount, err := readWriteOncePodCount(ctx, c.kubeClient, candidate)
+	if err != nil {
+		return 0, fmt.Errorf("counting stateful pods, %w", err)
+	}
+
+	costs := disruptionCostComponents(weights, candidate.disruptionCost, pdbCount, doNotDisruptCount, statefulCount)
+	var total float64
+	for component, cost := range costs {
+		disruptionCandidateCostGauge.With(map[string]string{componentLabel: component, nodeLabel: candidate.Node.Name}).Set(cost)
+		total += cost
+	}
+	return total, nil
+}
+
+// disruptionCostComponents computes the per-component weighted disruption cost breakdown. weights.Lifetime defaults
+// to 1 when unset (the Go zero value), so that clusters that don't configure DisruptionCostWeights keep the
+// pre-existing "order candidates by disruptionCost" behavior instead of every candidate scoring 0 and the sort
+// becoming a no-op.
+func disruptionCostComponents(weights options.DisruptionCostWeights, disruptionCost float64, pdbCount, doNotDisruptCount, statefulCount int) map[string]float64 {
+	lifetimeWeight := weights.Lifetime
+	if lifetimeWeight == 0 {
+		lifetimeWeight = 1
+	}
+	return map[string]float64{
+		"lifetime":     lifetimeWeight * disruptionCost,
+		"pdb":          weights.PDB * float64(pdbCount),
+		"donotdisrupt": weights.DoNotDisrupt * float64(doNotDisruptCount),
+		"stateful":     weights.Stateful * float64(statefulCount),
+	}
+}
+
+// pdbBlockedPodCount returns the number of candidate pods matched by a PodDisruptionBudget that currently has zero
+// disruptions allowed, i.e. pods that a naive eviction would be blocked on. pdbsByNamespace caches the
+// PodDisruptionBudgetList per namespace across calls so that candidates sharing a namespace, or a candidate with
+// many pods in the same namespace, only pay for one List.
+func pdbBlockedPodCount(ctx context.Context, kubeClient client.Client, candidate *Candidate, pdbsByNamespace map[string][]policyv1.PodDisruptionBudget) (int, error) {
+	count := 0
+	for _, pod := range candidate.pods {
+		pdbs, ok := pdbsByNamespace[pod.Namespace]
+		if !ok {
+			pdbList := &policyv1.PodDisruptionBudgetList{}
+			if err := kubeClient.List(ctx, pdbList, client.InNamespace(pod.Namespace)); err != nil {
+				return 0, err
+			}
+			pdbs = pdbList.Items
+			pdbsByNamespace[pod.Namespace] = pdbs
+		}
+		for i := range pdbs {
+			pdb := pdbs[i]
+			selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil || selector == nil {
+				continue
+			}
+			if selector.Matches(labels.Set(pod.Labels)) && pdb.Status.DisruptionsAllowed == 0 {
+				count++
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
+// readWriteOncePodCount returns the number of candidate pods that mount a PVC with a ReadWriteOnce access mode,
+// since those require a detach/attach cycle when the pod is rescheduled to a replacement node.
+func readWriteOncePodCount(ctx context.Context, kubeClient client.Client, candidate *Candidate) (int, error) {
+	count := 0
+	for _, pod := range candidate.pods {
+		for _, vol := range pod.Spec.Volumes {
+			if vol.PersistentVolumeClaim == nil {
+				continue
+			}
+			pvc := &v1.PersistentVolumeClaim{}
+			if err := kubeClient.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: vol.PersistentVolumeClaim.ClaimName}, pvc); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return 0, err
+			}
+			if lo.Contains(pvc.Spec.AccessModes, v1.ReadWriteOnce) {
+				count++
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
 // IsConsolidated returns true if nothing has changed since markConsolidated was called.
 func (c *consolidation) IsConsolidated() bool {
 	return c.lastConsolidationState.Equal(c.cluster.ConsolidationState())
@@ -197,6 +322,16 @@ func (c *consolidation) computeConsolidation(ctx context.Context, candidates ...
 		results.NewNodeClaims[0].Requirements.Add(scheduling.NewRequirement(v1beta1.CapacityTypeLabelKey, v1.NodeSelectorOpIn, v1beta1.CapacityTypeSpot))
 	}
 
+	// Unlike the spot-to-spot path, InstanceTypeOptions here hasn't been narrowed down to a small launch-flexibility
+	// window, so its worst (most expensive) price is just whatever's priced closest under candidatePrice. Judge
+	// against the cheapest compatible option instead, since that's what actually gets launched.
+	if ok, reason := c.meetsCostSavingsThreshold(ctx, candidates, candidatePrice, cheapestLaunchPrice(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, results.NewNodeClaims[0].Requirements)); !ok {
+		if len(candidates) == 1 {
+			c.recorder.Publish(disruptionevents.Unconsolidatable(candidates[0].Node, candidates[0].NodeClaim, reason)...)
+		}
+		return Command{}, nil
+	}
+
 	return Command{
 		candidates:   candidates,
 		replacements: results.NewNodeClaims,
@@ -206,8 +341,8 @@ func (c *consolidation) computeConsolidation(ctx context.Context, candidates ...
 // Compute command to execute spot-to-spot consolidation if:
 //  1. The SpotToSpotConsolidation feature flag is set to true.
 //  2. For single-node consolidation:
-//     a. There are at least 15 cheapest instance type replacement options to consolidate.
-//     b. The current candidate is NOT part of the first 15 cheapest instance types inorder to avoid repeated consolidation.
+//     a. There are at least minInstanceTypesForSpotToSpotConsolidation cheapest instance type replacement options to consolidate.
+//     b. The current candidate is NOT part of the first minInstanceTypesForSpotToSpotConsolidation cheapest instance types inorder to avoid repeated consolidation.
 func (c *consolidation) computeSpotToSpotConsolidation(ctx context.Context, candidates []*Candidate, results *pscheduling.Results,
 	candidatePrice float64) (Command, error) {
 
@@ -236,9 +371,31 @@ func (c *consolidation) computeSpotToSpotConsolidation(ctx context.Context, cand
 		return Command{}, nil
 	}
 
+	// Interruption-rate-aware filtering/ranking is opt-in since most cloud providers don't populate
+	// Offering.SpotInterruptionRate yet. This has to run before meetsCostSavingsThreshold below, since it can drop
+	// the cheapest instance type options and we need the cost-savings check to see the worst price of what's
+	// actually left to launch from, not the worst price of the pre-filter set.
+	if options.FromContext(ctx).FeatureGates.SpotInterruptionAwareConsolidation {
+		maxIncrease := spotConsolidationMaxInterruptionIncrease(candidates[0])
+		results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions = filterByInterruptionRate(
+			results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, results.NewNodeClaims[0].Requirements, candidateInterruptionRate(candidates), maxIncrease)
+		if len(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions) == 0 {
+			if len(candidates) == 1 {
+				c.recorder.Publish(disruptionevents.Unconsolidatable(candidates[0].Node, candidates[0].NodeClaim, "Can't replace spot node without increasing the interruption rate beyond the configured maximum")...)
+			}
+			return Command{}, nil
+		}
+		sortByInterruptionAwareScore(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, results.NewNodeClaims[0].Requirements)
+	}
+
 	// For multi-node consolidation:
 	// We don't have any requirement to check the remaining instance type flexibility, so exit early in this case.
+	// There's no further narrowing of InstanceTypeOptions for multi-node, so the cost-savings check below runs
+	// against the same set that's actually handed to the provider.
 	if len(candidates) > 1 {
+		if ok, _ := c.meetsCostSavingsThreshold(ctx, candidates, candidatePrice, worstLaunchPrice(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, results.NewNodeClaims[0].Requirements)); !ok {
+			return Command{}, nil
+		}
 		return Command{
 			candidates:   candidates,
 			replacements: results.NewNodeClaims,
@@ -246,23 +403,34 @@ func (c *consolidation) computeSpotToSpotConsolidation(ctx context.Context, cand
 	}
 
 	// For single-node consolidation:
-	// We check whether we have 15 cheaper instances than the current candidate instance. If this is the case, we know the following things:
-	//   1) The current candidate is not in the set of the 15 cheapest instance types and
-	//   2) There were at least 15 options cheaper than the current candidate.
-	if len(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions) < MinInstanceTypesForSpotToSpotConsolidation {
+	// We check whether we have enough cheaper instances than the current candidate instance. If this is the case, we know the following things:
+	//   1) The current candidate is not in the set of the minFlexibility cheapest instance types and
+	//   2) There were at least minFlexibility options cheaper than the current candidate.
+	minFlexibility := minInstanceTypesForSpotToSpotConsolidation(candidates[0])
+	if len(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions) < minFlexibility {
 		c.recorder.Publish(disruptionevents.Unconsolidatable(candidates[0].Node, candidates[0].NodeClaim, fmt.Sprintf("SpotToSpotConsolidation requires %d cheaper instance type options than the current candidate to consolidate, got %d",
-			MinInstanceTypesForSpotToSpotConsolidation, len(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions)))...)
+			minFlexibility, len(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions)))...)
 		return Command{}, nil
 	}
 
-	// Restrict the InstanceTypeOptions for launch to 15 so we don't get into a continual consolidation situation.
+	// Restrict the InstanceTypeOptions for launch to minFlexibility so we don't get into a continual consolidation situation.
 	// For example:
 	// 1) Suppose we have 5 instance types, (A, B, C, D, E) in order of price with the minimum flexibility 3 and they’ll all work for our pod.  We send CreateInstanceFromTypes(A,B,C,D,E) and it gives us a E type based on price and availability of spot.
 	// 2) We check if E is part of (A,B,C) and it isn't, so we will immediately have consolidation send a CreateInstanceFromTypes(A,B,C,D), since they’re cheaper than E.
 	// 3) Assuming CreateInstanceFromTypes(A,B,C,D) returned D, we check if D is part of (A,B,C) and it isn't, so will have another consolidation send a CreateInstanceFromTypes(A,B,C), since they’re cheaper than D resulting in continual consolidation.
 	// If we had restricted instance types to min flexibility at launch at step (1) i.e CreateInstanceFromTypes(A,B,C), we would have received the instance type part of the list preventing immediate consolidation.
-	// Taking this to 15 types, we need to only send the 15 cheapest types in the CreateInstanceFromTypes call so that the resulting instance is always in that set of 15 and we won’t immediately consolidate.
-	results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions = lo.Slice(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, 0, MinInstanceTypesForSpotToSpotConsolidation)
+	// Taking this to minFlexibility types, we need to only send the minFlexibility cheapest types in the CreateInstanceFromTypes call so that the resulting instance is always in that set and we won’t immediately consolidate.
+	// When SpotInterruptionAwareConsolidation is enabled, the options were ordered by sortByInterruptionAwareScore above,
+	// so this keeps the minFlexibility best-scored types, which are not necessarily the minFlexibility cheapest.
+	results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions = lo.Slice(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, 0, minFlexibility)
+
+	// The cost-savings check has to run against the minFlexibility-sliced set, not the full filtered set: the full
+	// set's worst price is a type priced just under the candidate, which would make the savings look ~0 even though
+	// the narrower launch set (the one actually handed to the provider) clears the threshold easily.
+	if ok, reason := c.meetsCostSavingsThreshold(ctx, candidates, candidatePrice, worstLaunchPrice(results.NewNodeClaims[0].NodeClaimTemplate.InstanceTypeOptions, results.NewNodeClaims[0].Requirements)); !ok {
+		c.recorder.Publish(disruptionevents.Unconsolidatable(candidates[0].Node, candidates[0].NodeClaim, reason)...)
+		return Command{}, nil
+	}
 
 	return Command{
 		candidates:   candidates,
@@ -270,6 +438,159 @@ func (c *consolidation) computeSpotToSpotConsolidation(ctx context.Context, cand
 	}, nil
 }
 
+// minInstanceTypesForSpotToSpotConsolidation returns the minimum number of cheaper instance type options a spot
+// candidate must have available in order to be considered for spot-to-spot single-node consolidation. NodePools can
+// override the package default via Spec.Disruption.SpotToSpotConsolidationMinInstanceTypes, since the right flexibility
+// floor to avoid consolidation churn depends on the cloud provider's spot allocation strategy (e.g. a
+// capacity-optimized strategy needs less flexibility than lowest-price to stay stable). The override is clamped to at
+// least 1: a configured 0 or negative value would otherwise pass the "at least minFlexibility options" guard with
+// InstanceTypeOptions sliced down to nothing, handing the provider an empty launch set.
+func minInstanceTypesForSpotToSpotConsolidation(candidate *Candidate) int {
+	if m := candidate.nodePool.Spec.Disruption.SpotToSpotConsolidationMinInstanceTypes; m != nil {
+		if *m < 1 {
+			return 1
+		}
+		return *m
+	}
+	return MinInstanceTypesForSpotToSpotConsolidation
+}
+
+// worstLaunchPrice returns the highest priced offering among the given instance types that is compatible with the
+// requirements. Since we don't know which of the remaining instance type options will actually be launched, we
+// conservatively use the most expensive one to decide whether a command still clears the cost-savings bar.
+func worstLaunchPrice(instanceTypes cloudprovider.InstanceTypes, requirements scheduling.Requirements) float64 {
+	// We need the instance types that are compatible with our requirements
+	var price float64
+	for _, it := range instanceTypes {
+		if compatibleOfferings := it.Offerings.Compatible(requirements); len(compatibleOfferings) > 0 {
+			if p := compatibleOfferings.MostExpensive().Price; p > price {
+				price = p
+			}
+		}
+	}
+	return price
+}
+
+// cheapestLaunchPrice returns the lowest priced offering among the given instance types that is compatible with the
+// requirements. Unlike worstLaunchPrice, this reflects what will actually be launched: the on-demand path doesn't
+// narrow InstanceTypeOptions down to a small, price-ordered launch set the way spot-to-spot does, so the worst price
+// across the whole filterByPrice-filtered set is just whatever happens to be priced closest under candidatePrice —
+// almost always clearing any cost-savings threshold by only a hair. The provider launches the cheapest compatible
+// option, so that's what the cost-savings check should be judged against.
+func cheapestLaunchPrice(instanceTypes cloudprovider.InstanceTypes, requirements scheduling.Requirements) float64 {
+	price := math.MaxFloat64
+	for _, it := range instanceTypes {
+		if compatibleOfferings := it.Offerings.Compatible(requirements); len(compatibleOfferings) > 0 {
+			if p := compatibleOfferings.Cheapest().Price; p < price {
+				price = p
+			}
+		}
+	}
+	if price == math.MaxFloat64 {
+		return 0
+	}
+	return price
+}
+
+// meetsCostSavingsThreshold returns false along with a human-readable reason when the savings produced by replacing
+// candidates (summing to candidatePrice) with a node priced at replacementPrice (the caller picks the worst or the
+// cheapest launch price, depending on the consolidation path) fall below the
+// minimum absolute or percentage savings configured globally (options.Options) or overridden per-NodePool
+// (NodePool.Spec.Disruption). This keeps consolidation from chasing sub-cent savings by repeatedly swapping
+// near-identical spot instances.
+func (c *consolidation) meetsCostSavingsThreshold(ctx context.Context, candidates []*Candidate, candidatePrice, replacementPrice float64) (bool, string) {
+	minCostSavings := options.FromContext(ctx).MinCostSavings
+	minCostSavingsRatio := options.FromContext(ctx).MinCostSavingsRatio
+	if m := candidates[0].nodePool.Spec.Disruption.MinCostSavings; m != nil {
+		minCostSavings = *m
+	}
+	if m := candidates[0].nodePool.Spec.Disruption.MinCostSavingsRatio; m != nil {
+		minCostSavingsRatio = *m
+	}
+	savings := candidatePrice - replacementPrice
+	if minCostSavings > 0 && savings < minCostSavings {
+		return false, fmt.Sprintf("savings of $%.5f/hr are below the minimum of $%.5f/hr required to consolidate", savings, minCostSavings)
+	}
+	if minCostSavingsRatio > 0 && candidatePrice > 0 && savings/candidatePrice < minCostSavingsRatio {
+		return false, fmt.Sprintf("savings of %.2f%% are below the minimum of %.2f%% required to consolidate", 100*savings/candidatePrice, 100*minCostSavingsRatio)
+	}
+	return true, ""
+}
+
+// spotConsolidationMaxInterruptionIncrease returns how much higher (in interruption rate) a replacement instance
+// type is allowed to be than the current candidate before it's dropped from consideration. NodePools can tighten
+// this via Spec.Disruption.SpotConsolidationMaxInterruptionIncrease; left unset, we don't filter on interruption
+// rate at all (only the ranking in sortByInterruptionAwareScore applies).
+func spotConsolidationMaxInterruptionIncrease(candidate *Candidate) float64 {
+	if m := candidate.nodePool.Spec.Disruption.SpotConsolidationMaxInterruptionIncrease; m != nil {
+		return *m
+	}
+	return math.MaxFloat64
+}
+
+// candidateInterruptionRate returns the highest SpotInterruptionRate among the offerings backing the given
+// candidates, used as the baseline a replacement instance type's interruption rate is compared against.
+func candidateInterruptionRate(candidates []*Candidate) float64 {
+	var rate float64
+	for _, cn := range candidates {
+		offering, ok := cn.instanceType.Offerings.Get(cn.capacityType, cn.zone)
+		if !ok {
+			continue
+		}
+		if offering.SpotInterruptionRate > rate {
+			rate = offering.SpotInterruptionRate
+		}
+	}
+	return rate
+}
+
+// bestInterruptionRate returns the lowest SpotInterruptionRate among the offerings of it that are compatible with
+// requirements. The second return value is false if it has no offerings compatible with requirements; an offering
+// without interruption rate data counts with its zero SpotInterruptionRate.
+func bestInterruptionRate(it *cloudprovider.InstanceType, requirements scheduling.Requirements) (float64, bool) {
+	offerings := it.Offerings.Compatible(requirements)
+	if len(offerings) == 0 {
+		return 0, false
+	}
+	rate := math.MaxFloat64
+	for _, o := range offerings {
+		if o.SpotInterruptionRate < rate {
+			rate = o.SpotInterruptionRate
+		}
+	}
+	return rate, true
+}
+
+// filterByInterruptionRate drops instance types whose best available interruption rate exceeds currentRate by more
+// than maxIncrease. Instance types with no interruption rate data are kept so cloud providers that don't populate it
+// aren't penalized.
+func filterByInterruptionRate(instanceTypes cloudprovider.InstanceTypes, requirements scheduling.Requirements, currentRate, maxIncrease float64) cloudprovider.InstanceTypes {
+	return lo.Filter(instanceTypes, func(it *cloudprovider.InstanceType, _ int) bool {
+		rate, ok := bestInterruptionRate(it, requirements)
+		return !ok || rate <= currentRate+maxIncrease
+	})
+}
+
+// sortByInterruptionAwareScore orders instanceTypes so that the cheapest/least-interruptible options sort first,
+// rather than strictly by price. This keeps the top-minFlexibility slice (see minInstanceTypesForSpotToSpotConsolidation) we hand to the
+// cloud provider from being made up entirely of the cheapest-but-most-interrupted instance types.
+func sortByInterruptionAwareScore(instanceTypes cloudprovider.InstanceTypes, requirements scheduling.Requirements) {
+	sort.Slice(instanceTypes, func(i, j int) bool {
+		return interruptionAwareScore(instanceTypes[i], requirements) < interruptionAwareScore(instanceTypes[j], requirements)
+	})
+}
+
+// interruptionAwareScore combines an instance type's cheapest compatible offering price with its interruption rate
+// so that, all else equal, a lower-churn-risk instance type outranks a marginally cheaper but more interruptible one.
+func interruptionAwareScore(it *cloudprovider.InstanceType, requirements scheduling.Requirements) float64 {
+	offerings := it.Offerings.Compatible(requirements)
+	if len(offerings) == 0 {
+		return math.MaxFloat64
+	}
+	cheapest := offerings.Cheapest()
+	return cheapest.Price * (1 + cheapest.SpotInterruptionRate)
+}
+
 // getCandidatePrices returns the sum of the prices of the given candidates
 func getCandidatePrices(candidates []*Candidate) (float64, error) {
 	var price float64
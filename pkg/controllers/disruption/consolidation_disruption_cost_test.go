@@ -0,0 +1,45 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"testing"
+
+	"sigs.k8s.io/karpenter/pkg/operator/options"
+)
+
+// TestDisruptionCostComponents_DefaultWeightsPreserveLifetimeOrdering guards against a regression where an
+// unconfigured (zero-value) DisruptionCostWeights silently zeroes out every candidate's weighted cost, turning
+// sortAndFilterCandidates' sort into a no-op instead of the pre-existing "order by disruptionCost" behavior.
+func TestDisruptionCostComponents_DefaultWeightsPreserveLifetimeOrdering(t *testing.T) {
+	var zero options.DisruptionCostWeights
+
+	cheaper := sumCosts(disruptionCostComponents(zero, 1.0, 0, 0, 0))
+	pricier := sumCosts(disruptionCostComponents(zero, 2.0, 0, 0, 0))
+
+	if !(cheaper < pricier) {
+		t.Fatalf("expected default weights to order by disruptionCost alone, got cheaper=%v pricier=%v", cheaper, pricier)
+	}
+}
+
+func sumCosts(costs map[string]float64) float64 {
+	var total float64
+	for _, c := range costs {
+		total += c
+	}
+	return total
+}
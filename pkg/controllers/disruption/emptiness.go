@@ -19,8 +19,9 @@ package disruption
 import (
 	"context"
 	"fmt"
+	"sync"
 
-	"github.com/samber/lo"
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/utils/clock"
 
 	disruptionevents "sigs.k8s.io/karpenter/pkg/controllers/disruption/events"
@@ -30,17 +31,32 @@ import (
 	"sigs.k8s.io/karpenter/pkg/metrics"
 )
 
+// Skip reasons surfaced on Unconsolidatable events and the emptySkippedCounter for the Emptiness sub-reconciler.
+const (
+	emptinessSkipReasonNotYetExpired   = "not-yet-expired"
+	emptinessSkipReasonPodsPresent     = "pods-present"
+	emptinessSkipReasonBudgetExhausted = "budget-exhausted"
+	emptinessSkipReasonDeleting        = "deleting"
+)
+
 // Emptiness is a subreconciler that deletes empty candidates.
 // Emptiness will respect TTLSecondsAfterEmpty
 type Emptiness struct {
 	clock    clock.Clock
 	recorder events.Recorder
+
+	// notYetExpiredMu guards notYetExpired.
+	notYetExpiredMu sync.Mutex
+	// notYetExpired tracks the NodeClaims we've already published a not-yet-expired Unconsolidatable event for, so
+	// ShouldDisrupt only emits it once per wait period instead of on every reconcile.
+	notYetExpired map[string]struct{}
 }
 
 func NewEmptiness(clk clock.Clock, recorder events.Recorder) *Emptiness {
 	return &Emptiness{
-		clock:    clk,
-		recorder: recorder,
+		clock:         clk,
+		recorder:      recorder,
+		notYetExpired: map[string]struct{}{},
 	}
 }
 
@@ -50,39 +66,86 @@ func (e *Emptiness) ShouldDisrupt(_ context.Context, c *Candidate) bool {
 	// we should also not fire an event here to users since this can be confusing when the field on the NodePool
 	// is named "consolidationPolicy"
 	if c.nodePool.Spec.Disruption.ConsolidationPolicy != v1beta1.ConsolidationPolicyWhenEmpty {
+		e.clearNotYetExpired(c)
 		return false
 	}
 	if c.nodePool.Spec.Disruption.ConsolidateAfter != nil && c.nodePool.Spec.Disruption.ConsolidateAfter.Duration == nil {
+		e.clearNotYetExpired(c)
 		e.recorder.Publish(disruptionevents.Unconsolidatable(c.Node, c.NodeClaim, fmt.Sprintf("NodePool %q has consolidation disabled", c.nodePool.Name))...)
 		return false
 	}
-	return c.NodeClaim.StatusConditions().GetCondition(v1beta1.Empty).IsTrue() &&
-		!e.clock.Now().Before(c.NodeClaim.StatusConditions().GetCondition(v1beta1.Empty).LastTransitionTime.Inner.Add(*c.nodePool.Spec.Disruption.ConsolidateAfter.Duration))
+	if !c.NodeClaim.StatusConditions().GetCondition(v1beta1.Empty).IsTrue() {
+		e.clearNotYetExpired(c)
+		return false
+	}
+	remaining := c.NodeClaim.StatusConditions().GetCondition(v1beta1.Empty).LastTransitionTime.Inner.Add(*c.nodePool.Spec.Disruption.ConsolidateAfter.Duration).Sub(e.clock.Now())
+	emptyConsolidateAfterSecondsGauge.With(prometheus.Labels{nodePoolLabel: c.nodePool.Name, nodeLabel: c.Node.Name}).Set(remaining.Seconds())
+	if remaining > 0 {
+		// emptyConsolidateAfterSecondsGauge above already gives continuous visibility into "empty but waiting", so
+		// we only need the Unconsolidatable event once per wait period — not on every reconcile, which would spam
+		// clusters with many empty pools sitting on a ConsolidateAfter timer.
+		if e.markNotYetExpired(c.NodeClaim.Name) {
+			e.recordSkip(c, emptinessSkipReasonNotYetExpired)
+		} else {
+			emptySkippedCounter.With(prometheus.Labels{reasonLabel: emptinessSkipReasonNotYetExpired}).Inc()
+		}
+		return false
+	}
+	e.clearNotYetExpired(c)
+	return true
+}
+
+// markNotYetExpired records that nodeClaimName is currently waiting out ConsolidateAfter, returning true the first
+// time it's called for a given wait period (i.e. until clearNotYetExpired is called for the same name).
+func (e *Emptiness) markNotYetExpired(nodeClaimName string) bool {
+	e.notYetExpiredMu.Lock()
+	defer e.notYetExpiredMu.Unlock()
+	if _, ok := e.notYetExpired[nodeClaimName]; ok {
+		return false
+	}
+	e.notYetExpired[nodeClaimName] = struct{}{}
+	return true
+}
+
+// clearNotYetExpired forgets c.NodeClaim.Name, so a future return to the not-yet-expired state (e.g. ConsolidateAfter
+// is bumped, or this is a new NodeClaim reusing the name) fires the Unconsolidatable event again. It also deletes c's
+// emptyConsolidateAfterSecondsGauge series, since a node that isn't waiting (or no longer exists) shouldn't keep
+// reporting a stale remaining-time value.
+func (e *Emptiness) clearNotYetExpired(c *Candidate) {
+	e.notYetExpiredMu.Lock()
+	defer e.notYetExpiredMu.Unlock()
+	delete(e.notYetExpired, c.NodeClaim.Name)
+	emptyConsolidateAfterSecondsGauge.Delete(prometheus.Labels{nodePoolLabel: c.nodePool.Name, nodeLabel: c.Node.Name})
 }
 
 // ComputeCommand generates a disruption command given candidates
 func (e *Emptiness) ComputeCommand(_ context.Context, disruptionBudgetMapping map[string]int, candidates ...*Candidate) (Command, error) {
-	// First check how many nodes are empty so that we can emit a metric on how many nodes are eligible
-	emptyCandidates := lo.Filter(candidates, func(cn *Candidate, _ int) bool {
-		return cn.NodeClaim.DeletionTimestamp.IsZero() && len(cn.pods) == 0
-	})
-
 	disruptionEligibleNodesGauge.With(map[string]string{
 		methodLabel:            e.Type(),
 		consolidationTypeLabel: e.ConsolidationType(),
 	}).Set(float64(len(candidates)))
 
-	empty := make([]*Candidate, 0, len(emptyCandidates))
-	for _, candidate := range emptyCandidates {
+	empty := make([]*Candidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !candidate.NodeClaim.DeletionTimestamp.IsZero() {
+			// The NodeClaim is on its way out; forget any pending not-yet-expired wait so we don't leak an entry for
+			// a name that could be reused, or suppress the event if it somehow comes back.
+			e.clearNotYetExpired(candidate)
+			e.recordSkip(candidate, emptinessSkipReasonDeleting)
+			continue
+		}
 		if len(candidate.pods) > 0 {
+			e.recordSkip(candidate, emptinessSkipReasonPodsPresent)
 			continue
 		}
-		// If there's disruptions allowed for the candidate's nodepool,
-		// add it to the list of candidates, and decrement the budget.
-		if disruptionBudgetMapping[candidate.nodePool.Name] > 0 {
-			empty = append(empty, candidate)
-			disruptionBudgetMapping[candidate.nodePool.Name]--
+		// If there's no disruptions allowed left for the candidate's nodepool, skip it and leave the budget untouched.
+		if disruptionBudgetMapping[candidate.nodePool.Name] <= 0 {
+			e.recordSkip(candidate, emptinessSkipReasonBudgetExhausted)
+			continue
 		}
+		empty = append(empty, candidate)
+		disruptionBudgetMapping[candidate.nodePool.Name]--
+		emptySelectedCounter.With(prometheus.Labels{nodePoolLabel: candidate.nodePool.Name}).Inc()
 	}
 
 	return Command{
@@ -90,6 +153,13 @@ func (e *Emptiness) ComputeCommand(_ context.Context, disruptionBudgetMapping ma
 	}, nil
 }
 
+// recordSkip publishes an Unconsolidatable event and increments emptySkippedCounter for a candidate that was passed
+// over during emptiness disruption, labeled by the reason it was skipped.
+func (e *Emptiness) recordSkip(c *Candidate, reason string) {
+	e.recorder.Publish(disruptionevents.Unconsolidatable(c.Node, c.NodeClaim, reason)...)
+	emptySkippedCounter.With(prometheus.Labels{reasonLabel: reason}).Inc()
+}
+
 func (e *Emptiness) Type() string {
 	return metrics.EmptinessReason
 }
@@ -0,0 +1,82 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/karpenter/pkg/metrics"
+)
+
+const (
+	reasonLabel    = "reason"
+	nodePoolLabel  = "nodepool"
+	componentLabel = "component"
+	nodeLabel      = "node"
+)
+
+var (
+	// emptySkippedCounter tracks why a candidate considered for emptiness-based disruption was passed over.
+	emptySkippedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.DisruptionSubsystem,
+			Name:      "empty_skipped_total",
+			Help:      "Number of empty-node disruption candidates skipped, labeled by the reason they were skipped.",
+		},
+		[]string{reasonLabel},
+	)
+	// emptySelectedCounter tracks nodes that were selected for termination by the Emptiness sub-reconciler.
+	emptySelectedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.DisruptionSubsystem,
+			Name:      "empty_selected_total",
+			Help:      "Number of empty nodes selected for termination by the Emptiness sub-reconciler, labeled by nodepool.",
+		},
+		[]string{nodePoolLabel},
+	)
+	// emptyConsolidateAfterSecondsGauge reports how long an empty node still needs to wait out ConsolidateAfter. It's
+	// labeled by node (in addition to nodepool) so that multiple empty nodes waiting in the same pool each get their
+	// own series instead of flapping to whichever node ShouldDisrupt last visited; the series for a node is deleted
+	// once it stops waiting (see Emptiness.clearNotYetExpired) so it doesn't linger with a stale value.
+	emptyConsolidateAfterSecondsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.DisruptionSubsystem,
+			Name:      "empty_consolidate_after_seconds",
+			Help:      "Remaining time in seconds before an empty node becomes eligible for emptiness-based disruption, labeled by nodepool and node. The series is removed once the node is no longer waiting.",
+		},
+		[]string{nodePoolLabel, nodeLabel},
+	)
+	// disruptionCandidateCostGauge reports the weighted disruption cost breakdown computed for a consolidation
+	// candidate, labeled by component (lifetime, pdb, donotdisrupt, stateful) and node.
+	disruptionCandidateCostGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.DisruptionSubsystem,
+			Name:      "candidate_cost",
+			Help:      "Weighted disruption cost computed for a consolidation candidate, labeled by component (lifetime, pdb, donotdisrupt, stateful) and node.",
+		},
+		[]string{componentLabel, nodeLabel},
+	)
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(emptySkippedCounter, emptySelectedCounter, emptyConsolidateAfterSecondsGauge, disruptionCandidateCostGauge)
+}
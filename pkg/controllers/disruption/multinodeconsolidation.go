@@ -0,0 +1,271 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruption
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/karpenter/pkg/operator/options"
+	"sigs.k8s.io/karpenter/pkg/utils/resources"
+)
+
+// Default bounds for the bounded-beam search below, used whenever the corresponding option is unset or non-positive.
+const (
+	defaultMultiNodeConsolidationBeamWidth    = 10
+	defaultMultiNodeConsolidationMaxCandidate = 10
+)
+
+// multiNodeConsolidation is the consolidation controller that tries to consolidate more than one node at a time.
+// It isn't restricted to a contiguous prefix of the disruption-cost-sorted candidates: it
+// searches non-contiguous subsets for the combination that maximizes savings.
+type multiNodeConsolidation struct {
+	consolidation
+}
+
+func NewMultiNodeConsolidation(consolidation consolidation) *multiNodeConsolidation {
+	return &multiNodeConsolidation{consolidation: consolidation}
+}
+
+func (m *multiNodeConsolidation) ComputeCommand(ctx context.Context, disruptionBudgetMapping map[string]int, candidates ...*Candidate) (Command, error) {
+	if m.IsConsolidated() {
+		return Command{}, nil
+	}
+	candidates, err := m.sortAndFilterCandidates(ctx, candidates)
+	if err != nil {
+		return Command{}, fmt.Errorf("sorting candidates, %w", err)
+	}
+	// Filter out nodepools that have no disruption budget left; a subset that includes one of these can never be
+	// executed, so there's no point spending search budget on it.
+	candidates = lo.Filter(candidates, func(c *Candidate, _ int) bool {
+		return disruptionBudgetMapping[c.nodePool.Name] > 0
+	})
+	if len(candidates) < 2 {
+		return Command{}, nil
+	}
+	cmd, err := m.beamSearch(ctx, candidates, disruptionBudgetMapping)
+	if err != nil {
+		return Command{}, err
+	}
+	for _, cn := range cmd.candidates {
+		disruptionBudgetMapping[cn.nodePool.Name]--
+	}
+	return cmd, nil
+}
+
+// beamSearch performs a bounded-beam search over subsets of candidates of size 2..N, keeping the K most promising
+// subsets ("the beam") at each size and extending each by one more candidate to reach the next size. It returns the
+// best command found — the subset whose simulated replacement produces at most one new NodeClaim and maximizes
+// candidatePrice-replacementPrice — bailing out with the best-so-far once consolidationTTL has elapsed, since this
+// is also the wall-clock budget the caller uses to validate the resulting command. disruptionBudgetMapping is read
+// (never mutated here) to prune subsets that pull more candidates from a single nodepool than that nodepool has
+// disruption budget remaining; the caller is responsible for decrementing it once the returned command is accepted,
+// matching how Emptiness.ComputeCommand consumes the budget.
+func (m *multiNodeConsolidation) beamSearch(ctx context.Context, candidates []*Candidate, disruptionBudgetMapping map[string]int) (Command, error) {
+	opts := options.FromContext(ctx)
+	beamWidth := opts.MultiNodeConsolidationBeamWidth
+	if beamWidth <= 0 {
+		beamWidth = defaultMultiNodeConsolidationBeamWidth
+	}
+	maxCandidates := opts.MultiNodeConsolidationMaxCandidates
+	if maxCandidates <= 0 {
+		maxCandidates = defaultMultiNodeConsolidationMaxCandidate
+	}
+	if maxCandidates > len(candidates) {
+		maxCandidates = len(candidates)
+	}
+
+	deadline := m.clock.Now().Add(consolidationTTL)
+	largestAllocatable, err := m.largestInstanceTypeAllocatable(ctx, candidates)
+	if err != nil {
+		return Command{}, fmt.Errorf("getting largest available instance type, %w", err)
+	}
+	memo := map[string]Command{}
+
+	var best Command
+	bestSavings := 0.0
+
+	// Seed the beam with every individual candidate so we can grow it one node at a time.
+	beam := lo.Map(candidates, func(c *Candidate, _ int) []*Candidate { return []*Candidate{c} })
+
+	for size := 2; size <= maxCandidates; size++ {
+		if m.clock.Now().After(deadline) {
+			break
+		}
+		var next []scoredSubset
+		for _, subset := range beam {
+			for _, c := range candidates {
+				if m.clock.Now().After(deadline) {
+					break
+				}
+				if containsCandidate(subset, c) {
+					continue
+				}
+				extended := append(append([]*Candidate{}, subset...), c)
+				if exceedsLargestInstanceType(extended, largestAllocatable) {
+					// Pruned: this subset's aggregate allocatable already exceeds the largest instance type we
+					// could possibly consolidate onto, so no single replacement NodeClaim can absorb it.
+					continue
+				}
+				if exceedsDisruptionBudget(extended, disruptionBudgetMapping) {
+					// Pruned: this subset would disrupt more candidates from some nodepool than that nodepool has
+					// budget remaining, so it could never be executed even if it scored best.
+					continue
+				}
+
+				key := subsetMemoKey(extended)
+				cmd, ok := memo[key]
+				if !ok {
+					var err error
+					cmd, err = m.computeConsolidation(ctx, extended...)
+					if err != nil {
+						return Command{}, err
+					}
+					memo[key] = cmd
+				}
+				if len(cmd.candidates) == 0 || len(cmd.replacements) > 1 {
+					continue
+				}
+
+				savings, err := consolidationSavings(cmd)
+				if err != nil {
+					return Command{}, err
+				}
+				if savings > bestSavings {
+					bestSavings = savings
+					best = cmd
+				}
+				next = append(next, scoredSubset{subset: extended, savings: savings})
+			}
+		}
+		if len(next) == 0 {
+			break
+		}
+		sort.Slice(next, func(i, j int) bool { return next[i].savings > next[j].savings })
+		if len(next) > beamWidth {
+			next = next[:beamWidth]
+		}
+		beam = lo.Map(next, func(s scoredSubset, _ int) []*Candidate { return s.subset })
+	}
+
+	return best, nil
+}
+
+type scoredSubset struct {
+	subset  []*Candidate
+	savings float64
+}
+
+func containsCandidate(subset []*Candidate, c *Candidate) bool {
+	for _, sc := range subset {
+		if sc == c {
+			return true
+		}
+	}
+	return false
+}
+
+// consolidationSavings returns candidatePrice-replacementPrice for an already-computed command.
+func consolidationSavings(cmd Command) (float64, error) {
+	candidatePrice, err := getCandidatePrices(cmd.candidates)
+	if err != nil {
+		return 0, err
+	}
+	if len(cmd.replacements) == 0 {
+		return candidatePrice, nil
+	}
+	return candidatePrice - worstLaunchPrice(cmd.replacements[0].NodeClaimTemplate.InstanceTypeOptions, cmd.replacements[0].Requirements), nil
+}
+
+// largestInstanceTypeAllocatable returns the largest CPU capacity among the instance types the cloud provider can
+// actually offer for the candidates' nodepools, used as a conservative upper bound for pruning subsets that could
+// never fit onto a single replacement NodeClaim. This has to come from the cloud provider's full catalog rather than
+// from the candidates' own instance types: the whole point of consolidation is to land on a replacement that's
+// bigger than any one candidate but smaller than the sum of them, so bounding by a candidate's capacity would reject
+// every viable multi-node subset.
+func (m *multiNodeConsolidation) largestInstanceTypeAllocatable(ctx context.Context, candidates []*Candidate) (int64, error) {
+	seenNodePools := map[string]bool{}
+	var largest int64
+	for _, c := range candidates {
+		if seenNodePools[c.nodePool.Name] {
+			continue
+		}
+		seenNodePools[c.nodePool.Name] = true
+		instanceTypes, err := m.cloudProvider.GetInstanceTypes(ctx, c.nodePool)
+		if err != nil {
+			return 0, fmt.Errorf("getting instance types for nodepool %q, %w", c.nodePool.Name, err)
+		}
+		for _, it := range instanceTypes {
+			if cpu := it.Capacity.Cpu().MilliValue(); cpu > largest {
+				largest = cpu
+			}
+		}
+	}
+	return largest, nil
+}
+
+// exceedsLargestInstanceType reports whether the aggregate CPU requested by the pods scheduled across the candidates
+// already exceeds the largest instance type available, in which case no single replacement NodeClaim could ever
+// absorb them. We size against pod requests, not node allocatable capacity: the replacement only needs to fit the
+// workload, and bounding by capacity would prune subsets whose pods are packed far below what their nodes provide.
+func exceedsLargestInstanceType(candidates []*Candidate, largestAllocatable int64) bool {
+	if largestAllocatable <= 0 {
+		return false
+	}
+	var pods []*v1.Pod
+	for _, c := range candidates {
+		pods = append(pods, c.pods...)
+	}
+	return resources.RequestsForPods(pods...).Cpu().MilliValue() > largestAllocatable
+}
+
+// exceedsDisruptionBudget reports whether candidates contains, for any nodepool, more candidates than that
+// nodepool's remaining disruptionBudgetMapping entry allows. ComputeCommand already drops candidates from
+// fully-exhausted nodepools before the search starts, but that alone doesn't stop a subset from combining, say, 2
+// candidates from a nodepool whose budget is 1.
+func exceedsDisruptionBudget(candidates []*Candidate, disruptionBudgetMapping map[string]int) bool {
+	counts := map[string]int{}
+	for _, c := range candidates {
+		counts[c.nodePool.Name]++
+		if counts[c.nodePool.Name] > disruptionBudgetMapping[c.nodePool.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+// subsetMemoKey builds a stable memoization key from the multiset of pod UIDs and candidate instance type names in
+// the subset, so that re-exploring the same combination of pods+instance-types during the beam search reuses the
+// already-simulated scheduling result instead of re-running simulateScheduling.
+func subsetMemoKey(candidates []*Candidate) string {
+	var instanceTypes, pods []string
+	for _, c := range candidates {
+		instanceTypes = append(instanceTypes, c.instanceType.Name)
+		for _, p := range c.pods {
+			pods = append(pods, string(p.UID))
+		}
+	}
+	sort.Strings(instanceTypes)
+	sort.Strings(pods)
+	return strings.Join(instanceTypes, ",") + "|" + strings.Join(pods, ",") + "|" + strconv.Itoa(len(candidates))
+}